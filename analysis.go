@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/notnil/chess"
+)
+
+// AnalyzeRequest defines the JSON request body for the /analyze endpoint.
+type AnalyzeRequest struct {
+	CurrentFEN string `json:"current_fen" form:"current_fen" binding:"required"`
+	Depth      int    `json:"depth" form:"depth"`
+	MoveTime   int    `json:"move_time" form:"move_time"`
+	MultiPV    int    `json:"multi_pv" form:"multi_pv"`
+}
+
+// PVResult describes one candidate line from a multi-PV search.
+type PVResult struct {
+	Rank    int      `json:"rank"`
+	Moves   []string `json:"moves"`
+	ScoreCP int      `json:"score_cp,omitempty"`
+	Mate    int      `json:"mate,omitempty"`
+	Depth   int      `json:"depth"`
+}
+
+// AnalyzeResponse defines the JSON response body for the /analyze endpoint.
+type AnalyzeResponse struct {
+	FEN   string     `json:"fen"`
+	Lines []PVResult `json:"lines"`
+	Depth int        `json:"depth"`
+	Nodes int        `json:"nodes"`
+	NPS   int        `json:"nps"`
+}
+
+const (
+	defaultMultiPV = 3
+	maxMultiPV     = 10
+)
+
+// analyzeHandler runs a multi-PV Stockfish search on a position and returns
+// the top candidate moves with their evaluation and principal variation,
+// rather than only the single best move moveHandler returns.
+func analyzeHandler(c *gin.Context) {
+	var req AnalyzeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	if _, err := chess.FEN(req.CurrentFEN); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid FEN string"})
+		return
+	}
+
+	if req.Depth == 0 && req.MoveTime == 0 {
+		req.MoveTime = 1000
+	}
+	req.Depth = engineLimits.clampDepth(req.Depth)
+	req.MoveTime = engineLimits.clampMoveTime(req.MoveTime)
+
+	multiPV := req.MultiPV
+	if multiPV <= 0 {
+		multiPV = defaultMultiPV
+	}
+	if multiPV > maxMultiPV {
+		multiPV = maxMultiPV
+	}
+
+	lines, err := searchMultiPV(c.Request.Context(), enginePool.path, req.CurrentFEN, multiPV, req.Depth, req.MoveTime)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Analysis failed: " + err.Error()})
+		return
+	}
+	if len(lines) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine returned no analysis"})
+		return
+	}
+
+	resp := AnalyzeResponse{
+		FEN:   req.CurrentFEN,
+		Depth: lines[0].Depth,
+		Nodes: lines[0].Nodes,
+		NPS:   lines[0].NPS,
+	}
+	for _, l := range lines {
+		pv := PVResult{Rank: l.MultiPV, Moves: l.PV, Depth: l.Depth}
+		if l.IsMate {
+			pv.Mate = l.Mate
+		} else {
+			pv.ScoreCP = l.ScoreCP
+		}
+		resp.Lines = append(resp.Lines, pv)
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// searchMultiPV drives a standalone Stockfish process (bypassing the pool, so
+// the call can't starve handlers that only need a single best move) through a
+// MultiPV search and returns the final info line for each requested PV
+// ranked by multipv index, plus the bestmove's line as index 1. ctx governs
+// how long the call waits for a free raw-session slot.
+func searchMultiPV(ctx context.Context, enginePath, fen string, multiPV, depth, moveTimeMs int) ([]infoLine, error) {
+	session, err := newRawSession(ctx, enginePath)
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	cmds := []string{
+		fmt.Sprintf("setoption name MultiPV value %d", multiPV),
+		"isready",
+		"ucinewgame",
+		fmt.Sprintf("position fen %s", fen),
+	}
+	for _, cmd := range cmds {
+		if err := session.send(cmd); err != nil {
+			return nil, err
+		}
+		if cmd == "isready" {
+			if _, err := session.waitFor("readyok"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	goCmd := "go "
+	if depth > 0 {
+		goCmd += fmt.Sprintf("depth %d", depth)
+	} else {
+		if moveTimeMs == 0 {
+			moveTimeMs = 1000
+		}
+		goCmd += fmt.Sprintf("movetime %d", moveTimeMs)
+	}
+	if err := session.send(goCmd); err != nil {
+		return nil, err
+	}
+
+	latest := make(map[int]infoLine)
+	_, err = session.readSearch(func(line string) {
+		if info, ok := parseInfoLine(line); ok {
+			latest[info.MultiPV] = info
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]infoLine, 0, len(latest))
+	for _, l := range latest {
+		lines = append(lines, l)
+	}
+	sort.Slice(lines, func(i, j int) bool { return lines[i].MultiPV < lines[j].MultiPV })
+	return lines, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"crypto/rand"
+	"math"
+	"math/big"
+)
+
+// blunderDecayCP sets how quickly a candidate's pick probability falls off
+// with its centipawn loss versus the best line: losses much smaller than
+// this are picked about as often as the best alternative, losses much
+// larger are picked vanishingly rarely.
+const blunderDecayCP = 150.0
+
+// pickWeightedBlunder chooses a lower-ranked candidate move from a MultiPV
+// search, weighted so that small inaccuracies are far more likely than big
+// blunders (real human mistakes skew that way too). This produces
+// human-like inaccuracies instead of a literal random legal move, which
+// could include an instant self-mate. lines must be sorted by rank
+// (lines[0] is the engine's actual best move).
+func pickWeightedBlunder(lines []infoLine) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	if len(lines) == 1 {
+		return firstMove(lines[0])
+	}
+
+	best := lines[0].centipawns()
+	type candidate struct {
+		move   string
+		weight float64
+	}
+	var candidates []candidate
+	for _, l := range lines[1:] {
+		move := firstMove(l)
+		if move == "" {
+			continue
+		}
+		loss := float64(best - l.centipawns())
+		if loss < 1 {
+			loss = 1
+		}
+		weight := math.Exp(-loss / blunderDecayCP)
+		candidates = append(candidates, candidate{move: move, weight: weight})
+	}
+	if len(candidates) == 0 {
+		return firstMove(lines[0])
+	}
+
+	var total float64
+	for _, c := range candidates {
+		total += c.weight
+	}
+
+	const precision = 1 << 53
+	r, _ := rand.Int(rand.Reader, big.NewInt(precision))
+	target := (float64(r.Int64()) / float64(precision)) * total
+	for _, c := range candidates {
+		target -= c.weight
+		if target <= 0 {
+			return c.move
+		}
+	}
+	return candidates[len(candidates)-1].move
+}
+
+func firstMove(l infoLine) string {
+	if len(l.PV) == 0 {
+		return ""
+	}
+	return l.PV[0]
+}
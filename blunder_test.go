@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestPickWeightedBlunderFavorsSmallerLoss(t *testing.T) {
+	lines := []infoLine{
+		{MultiPV: 1, ScoreCP: 50, PV: []string{"e2e4"}},
+		{MultiPV: 2, ScoreCP: 40, PV: []string{"d2d4"}},   // small loss
+		{MultiPV: 3, ScoreCP: -900, PV: []string{"g1h3"}}, // huge blunder
+	}
+
+	counts := map[string]int{}
+	const trials = 2000
+	for i := 0; i < trials; i++ {
+		counts[pickWeightedBlunder(lines)]++
+	}
+
+	if counts["d2d4"] <= counts["g1h3"] {
+		t.Fatalf("expected the small-loss move to be picked more often than the huge blunder, got %v", counts)
+	}
+}
+
+func TestPickWeightedBlunderSingleLine(t *testing.T) {
+	lines := []infoLine{{MultiPV: 1, ScoreCP: 50, PV: []string{"e2e4"}}}
+	if got := pickWeightedBlunder(lines); got != "e2e4" {
+		t.Fatalf("pickWeightedBlunder() = %q, want e2e4", got)
+	}
+}
+
+func TestPickWeightedBlunderEmpty(t *testing.T) {
+	if got := pickWeightedBlunder(nil); got != "" {
+		t.Fatalf("pickWeightedBlunder(nil) = %q, want empty string", got)
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+)
+
+// These constant offsets describe a Polyglot book key's 781 XOR terms: 64
+// squares * 12 piece types (768), 4 castling rights, 8 en-passant files, and
+// 1 side-to-move bit. The table itself (polyglotRandom) lives in
+// polyglot_random.go.
+const (
+	polyglotCastleOffset = 768
+	polyglotEPOffset     = 772
+	polyglotTurnOffset   = 780
+	polyglotTableSize    = 781
+)
+
+// bookEntry is one 16-byte Polyglot book record.
+type bookEntry struct {
+	Key    uint64
+	Move   uint16
+	Weight uint16
+}
+
+// OpeningBook is a loaded Polyglot book, grouped by position key so a lookup
+// can weight-pick among every move recorded for that position.
+type OpeningBook struct {
+	byKey map[uint64][]bookEntry
+}
+
+// loadOpeningBook reads a Polyglot .bin file into memory.
+func loadOpeningBook(path string) (*OpeningBook, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data)%16 != 0 {
+		return nil, fmt.Errorf("%s: not a valid Polyglot book (size not a multiple of 16 bytes)", path)
+	}
+
+	book := &OpeningBook{byKey: make(map[uint64][]bookEntry)}
+	for i := 0; i+16 <= len(data); i += 16 {
+		entry := bookEntry{
+			Key:    binary.BigEndian.Uint64(data[i : i+8]),
+			Move:   binary.BigEndian.Uint16(data[i+8 : i+10]),
+			Weight: binary.BigEndian.Uint16(data[i+10 : i+12]),
+		}
+		book.byKey[entry.Key] = append(book.byKey[entry.Key], entry)
+	}
+	log.Printf("Loaded opening book %s with %d positions", path, len(book.byKey))
+	return book, nil
+}
+
+// Move returns a weighted-random UCI move for the position encoded by fen,
+// and false if the book has nothing for it.
+func (b *OpeningBook) Move(fen string) (string, bool) {
+	entries := b.byKey[polyglotKey(fen)]
+	if len(entries) == 0 {
+		return "", false
+	}
+
+	total := 0
+	for _, e := range entries {
+		total += int(e.Weight) + 1
+	}
+	r, _ := rand.Int(rand.Reader, big.NewInt(int64(total)))
+	target := int(r.Int64())
+	for _, e := range entries {
+		target -= int(e.Weight) + 1
+		if target < 0 {
+			return decodePolyglotMove(e.Move), true
+		}
+	}
+	return decodePolyglotMove(entries[len(entries)-1].Move), true
+}
+
+// polyglotKey hashes a FEN position string into its Polyglot book key.
+func polyglotKey(fen string) uint64 {
+	fields := strings.Fields(fen)
+	if len(fields) < 4 {
+		return 0
+	}
+	placement, turn, castle, ep := fields[0], fields[1], fields[2], fields[3]
+
+	var key uint64
+	for r, rankStr := range strings.Split(placement, "/") {
+		rank := 7 - r
+		file := 0
+		for _, ch := range rankStr {
+			if ch >= '1' && ch <= '8' {
+				file += int(ch - '0')
+				continue
+			}
+			sq := rank*8 + file
+			key ^= polyglotRandom[polyglotPieceIndex(ch)*64+sq]
+			file++
+		}
+	}
+
+	for i, right := range []byte{'K', 'Q', 'k', 'q'} {
+		if strings.ContainsRune(castle, rune(right)) {
+			key ^= polyglotRandom[polyglotCastleOffset+i]
+		}
+	}
+
+	if ep != "-" && len(ep) == 2 {
+		key ^= polyglotRandom[polyglotEPOffset+int(ep[0]-'a')]
+	}
+
+	if turn == "w" {
+		key ^= polyglotRandom[polyglotTurnOffset]
+	}
+
+	return key
+}
+
+// polyglotPieceIndex maps a FEN piece letter to its Polyglot piece-table
+// slot: black pawn, white pawn, black knight, white knight, and so on.
+func polyglotPieceIndex(ch rune) int {
+	const order = "pPnNbBrRqQkK"
+	idx := strings.IndexRune(order, ch)
+	if idx < 0 {
+		return 0
+	}
+	return idx
+}
+
+// decodePolyglotMove turns a Polyglot move code into a UCI move string,
+// translating its "king captures own rook" castling encoding into the
+// king's actual destination square.
+func decodePolyglotMove(move uint16) string {
+	toFile := move & 0x7
+	toRank := (move >> 3) & 0x7
+	fromFile := (move >> 6) & 0x7
+	fromRank := (move >> 9) & 0x7
+	promo := (move >> 12) & 0x7
+
+	from := fmt.Sprintf("%c%d", 'a'+fromFile, fromRank+1)
+	to := fmt.Sprintf("%c%d", 'a'+toFile, toRank+1)
+
+	switch from + to {
+	case "e1h1":
+		to = "g1"
+	case "e1a1":
+		to = "c1"
+	case "e8h8":
+		to = "g8"
+	case "e8a8":
+		to = "c8"
+	}
+
+	uciMove := from + to
+	if promo >= 1 && promo <= 4 {
+		uciMove += string("\x00nbrq"[promo])
+	}
+	return uciMove
+}
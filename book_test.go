@@ -0,0 +1,53 @@
+package main
+
+import "testing"
+
+func TestPolyglotKeyStartPosition(t *testing.T) {
+	const startFEN = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+
+	key1 := polyglotKey(startFEN)
+	key2 := polyglotKey(startFEN)
+	if key1 != key2 {
+		t.Fatalf("polyglotKey is not deterministic: %x != %x", key1, key2)
+	}
+
+	blackToMove := "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR b KQkq - 0 1"
+	if polyglotKey(blackToMove) == key1 {
+		t.Fatalf("expected side-to-move to change the key")
+	}
+
+	noCastling := "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w - - 0 1"
+	if polyglotKey(noCastling) == key1 {
+		t.Fatalf("expected castling rights to change the key")
+	}
+}
+
+func TestDecodePolyglotMove(t *testing.T) {
+	cases := []struct {
+		name string
+		move uint16
+		want string
+	}{
+		{"e2e4 no promotion", 0x031C, "e2e4"},
+		{"white kingside castle encoding", 0x0107, "e1g1"},
+		{"white queenside castle encoding", 0x0100, "e1c1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := decodePolyglotMove(tc.move); got != tc.want {
+				t.Fatalf("decodePolyglotMove(%#04x) = %q, want %q", tc.move, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecodePolyglotMoveRejectsOutOfRangePromotion(t *testing.T) {
+	// promo occupies bits 12-14; 5, 6 and 7 are out of range for "\x00nbrq"
+	// and must not be indexed into it (would panic on a malformed book entry).
+	for _, promo := range []uint16{5, 6, 7} {
+		move := promo << 12
+		if got := decodePolyglotMove(move); got != "a1a1" {
+			t.Fatalf("decodePolyglotMove with out-of-range promo %d = %q, want %q (no promotion suffix)", promo, got, "a1a1")
+		}
+	}
+}
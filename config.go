@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"strconv"
+)
+
+// EngineLimits caps the per-request search knobs exposed to callers of
+// /move and /analyze, read once at startup so operators can bound resource
+// usage without a code change.
+type EngineLimits struct {
+	MaxDepth    int
+	MaxMoveTime int // milliseconds
+	MaxThreads  int
+}
+
+var engineLimits = loadEngineLimits()
+
+func loadEngineLimits() EngineLimits {
+	return EngineLimits{
+		MaxDepth:    envInt("MAX_DEPTH", 30),
+		MaxMoveTime: envInt("MAX_MOVE_TIME_MS", 30000),
+		MaxThreads:  envInt("MAX_THREADS", 8),
+	}
+}
+
+// clampDepth caps a requested search depth to MaxDepth; 0 (unset) passes through.
+func (l EngineLimits) clampDepth(depth int) int {
+	if depth > l.MaxDepth {
+		return l.MaxDepth
+	}
+	return depth
+}
+
+// clampMoveTime caps a requested move time, in milliseconds, to MaxMoveTime.
+func (l EngineLimits) clampMoveTime(moveTimeMs int) int {
+	if moveTimeMs > l.MaxMoveTime {
+		return l.MaxMoveTime
+	}
+	return moveTimeMs
+}
+
+// clampThreads caps a requested Threads option to MaxThreads.
+func (l EngineLimits) clampThreads(threads *int) *int {
+	if threads == nil || *threads <= l.MaxThreads {
+		return threads
+	}
+	capped := l.MaxThreads
+	return &capped
+}
+
+func envInt(key string, fallback int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || v <= 0 {
+		return fallback
+	}
+	return v
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"log"
 	"math/big"
 	"net/http"
@@ -18,70 +19,22 @@ import (
 	"github.com/notnil/chess/uci"
 )
 
-// EnginePool manages a pool of UCI chess engines to handle concurrent requests efficiently.
-type EnginePool struct {
-	engines chan *uci.Engine
-	path    string
-}
-
-// NewEnginePool creates and initializes a new engine pool.
-func NewEnginePool(enginePath string, poolSize int) (*EnginePool, error) {
-	pool := &EnginePool{
-		engines: make(chan *uci.Engine, poolSize),
-		path:    enginePath,
-	}
-
-	for i := 0; i < poolSize; i++ {
-		engine, err := uci.New(enginePath)
-		if err != nil {
-			pool.Close()
-			return nil, err
-		}
-
-		if err := engine.Run(uci.CmdUCI, uci.CmdIsReady, uci.CmdUCINewGame); err != nil {
-			engine.Close()
-			pool.Close()
-			return nil, err
-		}
-
-		pool.engines <- engine
-	}
-
-	log.Printf("Created engine pool with %d Stockfish instances", poolSize)
-	return pool, nil
-}
-
-// Get retrieves an engine from the pool, blocking until one is available.
-func (p *EnginePool) Get() *uci.Engine {
-	return <-p.engines
-}
-
-// Put returns an engine to the pool so it can be reused.
-func (p *EnginePool) Put(engine *uci.Engine) {
-	select {
-	case p.engines <- engine:
-	default:
-		engine.Close()
-	}
-}
-
-// Close cleanly shuts down all engines in the pool.
-func (p *EnginePool) Close() {
-	close(p.engines)
-	for engine := range p.engines {
-		engine.Close()
-	}
-}
-
 var enginePool *EnginePool
 
-// MoveRequest defines the structure of the JSON request body.
+// MoveRequest defines the structure of the JSON request body. CurrentFEN is
+// only required when GameID is empty; when a GameID is given, the server
+// loads the position from the GameStore instead.
 type MoveRequest struct {
 	UserMove   string `json:"user_move" binding:"required"`
-	CurrentFEN string `json:"current_fen" binding:"required"`
+	CurrentFEN string `json:"current_fen"`
+	GameID     string `json:"game_id"`
 	Depth      int    `json:"depth"`
 	MoveTime   int    `json:"move_time"`
 	Elo        int    `json:"elo"`
+	SkillLevel *int   `json:"skill_level"`
+	Contempt   *int   `json:"contempt"`
+	Threads    *int   `json:"threads"`
+	Hash       *int   `json:"hash"`
 }
 
 // MoveResponse defines the structure of the JSON response.
@@ -91,8 +44,17 @@ type MoveResponse struct {
 	EngineMoveStatus string `json:"engine_move_status"`
 	NewFEN           string `json:"new_fen"`
 	GameOutcome      string `json:"game_outcome"`
+	GameID           string `json:"game_id,omitempty"`
 }
 
+var gameStore GameStore
+
+// openingBook is optional; when set and the position is covered, low-Elo
+// requests play a book move instead of invoking Stockfish.
+var openingBook *OpeningBook
+
+const bookEloThreshold = 1800
+
 func main() {
 	stockfishPath, err := findStockfish()
 	if err != nil {
@@ -100,17 +62,33 @@ func main() {
 	}
 	log.Printf("Using Stockfish at: %s", stockfishPath)
 
-	poolSize := 5
-	enginePool, err = NewEnginePool(stockfishPath, poolSize)
+	poolSize := envInt("POOL_SIZE", 5)
+	maxUses := envInt("ENGINE_MAX_USES", 0) // 0 disables rotation
+	enginePool, err = NewEnginePool(stockfishPath, poolSize, maxUses)
 	if err != nil {
 		log.Fatalf("Failed to create engine pool: %v", err)
 	}
 
+	gameStore = newGameStore()
+
+	if bookPath := os.Getenv("BOOK_PATH"); bookPath != "" {
+		book, err := loadOpeningBook(bookPath)
+		if err != nil {
+			log.Printf("Failed to load opening book %s: %v", bookPath, err)
+		} else {
+			openingBook = book
+		}
+	}
+
 	router := gin.Default()
 
 	router.Use(cors.Default())
 
 	router.POST("/move", moveHandler)
+	router.POST("/analyze", analyzeHandler)
+	router.GET("/analyze/stream", analyzeStreamHandler)
+	router.POST("/game/pgn", importPGNHandler)
+	router.GET("/game/pgn", exportPGNHandler)
 
 	port := "8080"
 	srv := &http.Server{
@@ -138,10 +116,15 @@ func main() {
 
 	log.Println("Shutting down chess engine pool...")
 	enginePool.Close()
+	if err := gameStore.Close(); err != nil {
+		log.Printf("Failed to close game store: %v", err)
+	}
 	log.Println("Server exiting")
 }
 
-// moveHandler is updated to use UCI_Elo instead of Skill Level.
+// moveHandler applies the user's move, then replies with Stockfish's move at
+// the requested StrengthProfile, occasionally substituting a weighted
+// blunder for lower Elo requests.
 func moveHandler(c *gin.Context) {
 	var req MoveRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -152,21 +135,64 @@ func moveHandler(c *gin.Context) {
 	if req.Depth == 0 && req.MoveTime == 0 {
 		req.MoveTime = 1000
 	}
-
-	fen, err := chess.FEN(req.CurrentFEN)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid FEN string"})
+	req.Depth = engineLimits.clampDepth(req.Depth)
+	req.MoveTime = engineLimits.clampMoveTime(req.MoveTime)
+	req.Threads = engineLimits.clampThreads(req.Threads)
+
+	var history []string
+	startFEN := req.CurrentFEN
+	gameStartFEN := req.CurrentFEN
+	if req.GameID != "" {
+		rec, err := gameStore.Load(c.Request.Context(), req.GameID)
+		switch {
+		case err == nil:
+			startFEN = rec.FEN
+			gameStartFEN = rec.StartFEN
+			history = rec.History
+		case errors.Is(err, ErrGameNotFound):
+			if req.CurrentFEN == "" {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown game_id and no current_fen to start one"})
+				return
+			}
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load game: " + err.Error()})
+			return
+		}
+	} else if req.CurrentFEN == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "current_fen is required when no game_id is given"})
 		return
 	}
-	game := chess.NewGame(fen)
+
+	var opts []func(*chess.Game)
+	if gameStartFEN != "" {
+		fen, err := chess.FEN(gameStartFEN)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid FEN string"})
+			return
+		}
+		opts = append(opts, fen)
+	}
+	game := chess.NewGame(opts...)
+	for _, uciMove := range history {
+		move, err := findMoveByUCI(game, uciMove)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to replay game history: " + err.Error()})
+			return
+		}
+		if err := game.Move(move); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to replay game history: " + err.Error()})
+			return
+		}
+	}
 
 	userMove, err := findMoveByUCI(game, req.UserMove)
 	if err != nil {
 		log.Printf("User made an illegal move: %s", req.UserMove)
 		c.JSON(http.StatusOK, MoveResponse{
 			UserMoveStatus: "illegal-move",
-			NewFEN:         req.CurrentFEN,
+			NewFEN:         startFEN,
 			GameOutcome:    game.Outcome().String(),
+			GameID:         req.GameID,
 		})
 		return
 	}
@@ -179,18 +205,21 @@ func moveHandler(c *gin.Context) {
 	userMoveStatus := getMoveStatus(game, userMove)
 	if game.Outcome() != chess.NoOutcome {
 		log.Printf("Game over after user move. Outcome: %s", game.Outcome())
+		if req.GameID != "" {
+			if err := gameStore.Delete(c.Request.Context(), req.GameID); err != nil {
+				log.Printf("Failed to delete finished game %s: %v", req.GameID, err)
+			}
+		}
 		c.JSON(http.StatusOK, MoveResponse{
 			UserMoveStatus: userMoveStatus,
 			EngineMove:     "",
 			NewFEN:         game.Position().String(),
 			GameOutcome:    game.Outcome().String(),
+			GameID:         req.GameID,
 		})
 		return
 	}
 
-	engine := enginePool.Get()
-	defer enginePool.Put(engine)
-
 	const minBlunderElo = 400
 	const maxBlunderElo = 2000
 	const maxBlunderChance = 40
@@ -201,39 +230,73 @@ func moveHandler(c *gin.Context) {
 		blunderChance = int((1.0 - progress) * float64(maxBlunderChance))
 	}
 
-	eloCmds := []uci.Cmd{
-		uci.CmdSetOption{Name: "UCI_LimitStrength", Value: "true"},
-		uci.CmdSetOption{Name: "UCI_Elo", Value: "1320"},
-	}
-	if err := engine.Run(eloCmds...); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to set engine ELO: " + err.Error()})
-		return
-	}
-
-	cmdPos := uci.CmdPosition{Position: game.Position()}
-	cmdGo := uci.CmdGo{Depth: req.Depth, MoveTime: time.Duration(req.MoveTime) * time.Millisecond}
-	if err := engine.Run(cmdPos, cmdGo); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine search failed: " + err.Error()})
-		return
-	}
-
-	results := engine.SearchResults()
-	bestMove := results.BestMove
-	if bestMove == nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine could not find a move"})
-		return
+	var bestMove *chess.Move
+	if openingBook != nil && req.Elo > 0 && req.Elo < bookEloThreshold {
+		if bookMove, ok := openingBook.Move(game.Position().String()); ok {
+			if move, err := findMoveByUCI(game, bookMove); err == nil {
+				bestMove = move
+				log.Printf("Playing book move %s", bookMove)
+			}
+		}
 	}
 
 	randCheck, _ := rand.Int(rand.Reader, big.NewInt(100))
-	if int(randCheck.Int64()) < blunderChance {
-		log.Printf("!!! BLUNDERING !!! Chance was %d%%. Finding a random move.", blunderChance)
-		allMoves := game.ValidMoves()
-		if len(allMoves) > 0 {
-			randIndex, _ := rand.Int(rand.Reader, big.NewInt(int64(len(allMoves))))
-			bestMove = allMoves[randIndex.Int64()]
+	blundering := bestMove == nil && int(randCheck.Int64()) < blunderChance
+
+	if bestMove == nil && blundering {
+		log.Printf("!!! BLUNDERING !!! Chance was %d%%. Running a MultiPV search for a weighted alternative.", blunderChance)
+		lines, err := searchMultiPV(c.Request.Context(), enginePool.path, game.Position().String(), 5, req.Depth, req.MoveTime)
+		if err != nil || len(lines) == 0 {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine search failed"})
+			return
+		}
+		bestMove, err = findMoveByUCI(game, pickWeightedBlunder(lines))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error: Engine suggested illegal move"})
+			return
 		}
-	} else {
+	} else if bestMove == nil {
 		log.Printf("Not blundering. Chance was %d%%.", blunderChance)
+
+		engine, err := enginePool.GetContext(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Timed out waiting for an available engine"})
+			return
+		}
+		defer enginePool.Put(engine)
+
+		profile := NewStrengthProfile(req)
+		if err := engine.Run(profile.Commands()...); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to set engine strength: " + err.Error()})
+			return
+		}
+
+		searchDone := make(chan error, 1)
+		go func() {
+			cmdPos := uci.CmdPosition{Position: game.Position()}
+			cmdGo := uci.CmdGo{Depth: req.Depth, MoveTime: time.Duration(req.MoveTime) * time.Millisecond}
+			searchDone <- engine.Run(cmdPos, cmdGo)
+		}()
+
+		select {
+		case err := <-searchDone:
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine search failed: " + err.Error()})
+				return
+			}
+		case <-c.Request.Context().Done():
+			_ = enginePool.Stop(engine)
+			<-searchDone
+			c.JSON(http.StatusRequestTimeout, gin.H{"error": "Request canceled"})
+			return
+		}
+
+		results := engine.SearchResults()
+		bestMove = results.BestMove
+		if bestMove == nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine could not find a move"})
+			return
+		}
 	}
 
 	if err := game.Move(bestMove); err != nil {
@@ -245,12 +308,33 @@ func moveHandler(c *gin.Context) {
 	engineMoveStr := bestMove.String()
 	log.Printf("Engine move '%s'. Status: %s", engineMoveStr, engineMoveStatus)
 
+	if req.GameID != "" {
+		if game.Outcome() != chess.NoOutcome {
+			if err := gameStore.Delete(c.Request.Context(), req.GameID); err != nil {
+				log.Printf("Failed to delete finished game %s: %v", req.GameID, err)
+			}
+		} else {
+			rec := &GameRecord{
+				StartFEN: gameStartFEN,
+				FEN:      game.Position().String(),
+				History:  append(history, req.UserMove, engineMoveStr),
+				Elo:      req.Elo,
+				MoveTime: req.MoveTime,
+				Depth:    req.Depth,
+			}
+			if err := gameStore.Save(c.Request.Context(), req.GameID, rec); err != nil {
+				log.Printf("Failed to save game %s: %v", req.GameID, err)
+			}
+		}
+	}
+
 	c.JSON(http.StatusOK, MoveResponse{
 		UserMoveStatus:   userMoveStatus,
 		EngineMove:       engineMoveStr,
 		EngineMoveStatus: engineMoveStatus,
 		NewFEN:           game.Position().String(),
 		GameOutcome:      game.Outcome().String(),
+		GameID:           req.GameID,
 	})
 }
 
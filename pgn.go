@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/notnil/chess"
+)
+
+// ImportPGNRequest defines the JSON body for POST /game/pgn.
+type ImportPGNRequest struct {
+	PGN    string `json:"pgn" binding:"required"`
+	GameID string `json:"game_id"`
+}
+
+// ImportPGNResponse defines the JSON response for POST /game/pgn.
+type ImportPGNResponse struct {
+	GameID      string `json:"game_id"`
+	FEN         string `json:"fen"`
+	GameOutcome string `json:"game_outcome"`
+}
+
+// importPGNHandler resumes a game from PGN, storing it under GameID (or a
+// fresh id if none was given) so subsequent /move calls can continue it.
+func importPGNHandler(c *gin.Context) {
+	var req ImportPGNRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	pgnOpt, err := chess.PGN(strings.NewReader(req.PGN))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid PGN: " + err.Error()})
+		return
+	}
+	game := chess.NewGame(pgnOpt)
+
+	gameID := req.GameID
+	if gameID == "" {
+		gameID = fmt.Sprintf("pgn-%d", time.Now().UnixNano())
+	}
+
+	history := make([]string, 0, len(game.Moves()))
+	for _, move := range game.Moves() {
+		history = append(history, move.String())
+	}
+
+	rec := &GameRecord{StartFEN: game.Positions()[0].String(), FEN: game.Position().String(), History: history}
+	if err := gameStore.Save(c.Request.Context(), gameID, rec); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to store imported game: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ImportPGNResponse{
+		GameID:      gameID,
+		FEN:         game.Position().String(),
+		GameOutcome: game.Outcome().String(),
+	})
+}
+
+// exportPGNHandler rebuilds a stored game from its move history and returns
+// it as PGN, with headers and the result, and a getMoveStatus-derived
+// comment on any move that was a capture.
+func exportPGNHandler(c *gin.Context) {
+	gameID := c.Query("game_id")
+	if gameID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "game_id is required"})
+		return
+	}
+
+	rec, err := gameStore.Load(c.Request.Context(), gameID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown game_id"})
+		return
+	}
+
+	pgnText, err := buildPGN(rec.StartFEN, rec.History)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to rebuild game: " + err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.pgn"`, gameID))
+	c.String(http.StatusOK, "%s", pgnText)
+}
+
+// buildPGN replays a game's move history and renders it as PGN text.
+func buildPGN(startFEN string, history []string) (string, error) {
+	var opts []func(*chess.Game)
+	if startFEN != "" {
+		fenOpt, err := chess.FEN(startFEN)
+		if err != nil {
+			return "", err
+		}
+		opts = append(opts, fenOpt)
+	}
+	game := chess.NewGame(opts...)
+
+	var body strings.Builder
+	moveNum := 1
+	for i, uciMove := range history {
+		move, err := findMoveByUCI(game, uciMove)
+		if err != nil {
+			return "", fmt.Errorf("replaying move %q: %w", uciMove, err)
+		}
+		san := chess.AlgebraicNotation{}.Encode(game.Position(), move)
+		if err := game.Move(move); err != nil {
+			return "", err
+		}
+		status := getMoveStatus(game, move)
+
+		if i%2 == 0 {
+			fmt.Fprintf(&body, "%d. ", moveNum)
+		}
+		body.WriteString(san)
+		if status == "capture" {
+			body.WriteString(" {capture}")
+		}
+		body.WriteString(" ")
+		if i%2 == 1 {
+			moveNum++
+		}
+	}
+	body.WriteString(game.Outcome().String())
+
+	var header strings.Builder
+	tags := [][2]string{
+		{"Event", "stockfish_integrator game"},
+		{"Site", "?"},
+		{"Date", time.Now().Format("2006.01.02")},
+		{"Round", "-"},
+		{"White", "?"},
+		{"Black", "?"},
+		{"Result", game.Outcome().String()},
+	}
+	for _, tag := range tags {
+		fmt.Fprintf(&header, "[%s \"%s\"]\n", tag[0], tag[1])
+	}
+	header.WriteString("\n")
+
+	return header.String() + body.String(), nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/notnil/chess/uci"
+)
+
+// pooledEngine tracks how many searches an engine has served, so the pool
+// can rotate it out before a long-lived Stockfish process wedges.
+type pooledEngine struct {
+	engine *uci.Engine
+	uses   int
+}
+
+// EnginePool manages a pool of UCI chess engines to handle concurrent
+// requests efficiently, recycling engines that wedge or age out.
+type EnginePool struct {
+	engines chan *pooledEngine
+	path    string
+	maxUses int // 0 disables use-based rotation
+
+	mu    sync.Mutex
+	inUse map[*uci.Engine]*pooledEngine
+}
+
+// NewEnginePool creates and initializes a new engine pool. maxUses, if
+// positive, caps how many searches an engine serves before it's replaced
+// with a fresh Stockfish process.
+func NewEnginePool(enginePath string, poolSize, maxUses int) (*EnginePool, error) {
+	pool := &EnginePool{
+		engines: make(chan *pooledEngine, poolSize),
+		path:    enginePath,
+		maxUses: maxUses,
+		inUse:   make(map[*uci.Engine]*pooledEngine),
+	}
+
+	for i := 0; i < poolSize; i++ {
+		pe, err := pool.spawn()
+		if err != nil {
+			pool.Close()
+			return nil, err
+		}
+		pool.engines <- pe
+	}
+
+	log.Printf("Created engine pool with %d Stockfish instances", poolSize)
+	return pool, nil
+}
+
+// spawn starts and initializes a fresh Stockfish process.
+func (p *EnginePool) spawn() (*pooledEngine, error) {
+	engine, err := uci.New(p.path)
+	if err != nil {
+		return nil, err
+	}
+	if err := engine.Run(uci.CmdUCI, uci.CmdIsReady, uci.CmdUCINewGame); err != nil {
+		engine.Close()
+		return nil, err
+	}
+	return &pooledEngine{engine: engine}, nil
+}
+
+// Get retrieves an engine from the pool, blocking until one is available.
+func (p *EnginePool) Get() *uci.Engine {
+	engine, _ := p.GetContext(context.Background())
+	return engine
+}
+
+// GetContext retrieves an engine from the pool, respecting ctx cancellation
+// and request deadlines instead of blocking forever.
+func (p *EnginePool) GetContext(ctx context.Context) (*uci.Engine, error) {
+	select {
+	case pe := <-p.engines:
+		p.mu.Lock()
+		p.inUse[pe.engine] = pe
+		p.mu.Unlock()
+		return pe.engine, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Stop issues a UCI "stop" to an in-flight search, for callers that gave up
+// waiting on a result after ctx was canceled.
+func (p *EnginePool) Stop(engine *uci.Engine) error {
+	return engine.Run(uci.CmdStop)
+}
+
+// Put returns an engine to the pool so it can be reused. Before doing so it
+// re-isready's the engine; a dead or wedged engine is closed and replaced
+// with a freshly spawned one rather than handed to the next caller. An
+// engine that has served maxUses searches is rotated the same way.
+func (p *EnginePool) Put(engine *uci.Engine) {
+	p.mu.Lock()
+	pe, ok := p.inUse[engine]
+	delete(p.inUse, engine)
+	p.mu.Unlock()
+	if !ok {
+		// Not one of ours (or already recycled) — nothing to track.
+		engine.Close()
+		return
+	}
+	pe.uses++
+
+	needsRecycle := engine.Run(uci.CmdIsReady) != nil
+	if p.maxUses > 0 && pe.uses >= p.maxUses {
+		needsRecycle = true
+	}
+
+	if needsRecycle {
+		engine.Close()
+		fresh, err := p.spawn()
+		if err != nil {
+			log.Printf("Failed to respawn engine after recycling: %v", err)
+			return
+		}
+		pe = fresh
+	}
+
+	select {
+	case p.engines <- pe:
+	default:
+		pe.engine.Close()
+	}
+}
+
+// Close cleanly shuts down all engines in the pool.
+func (p *EnginePool) Close() {
+	close(p.engines)
+	for pe := range p.engines {
+		pe.engine.Close()
+	}
+}
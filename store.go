@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// GameRecord is the state persisted for one game, keyed by game id. Keeping
+// the move history (not just the latest FEN) lets threefold repetition and
+// the 50-move rule be tracked correctly across requests.
+type GameRecord struct {
+	StartFEN string   `json:"start_fen"`
+	FEN      string   `json:"fen"`
+	History  []string `json:"history"`
+	Elo      int      `json:"elo"`
+	MoveTime int      `json:"move_time"`
+	Depth    int      `json:"depth"`
+}
+
+// ErrGameNotFound is returned by GameStore.Load when the id isn't known.
+var ErrGameNotFound = errors.New("game not found")
+
+// GameStore persists game state by id so the client doesn't have to resend
+// the full FEN on every request, and so replicas behind a load balancer
+// share state instead of each one pinning a game to its own memory.
+type GameStore interface {
+	Load(ctx context.Context, gameID string) (*GameRecord, error)
+	Save(ctx context.Context, gameID string, rec *GameRecord) error
+	Delete(ctx context.Context, gameID string) error
+	Close() error
+}
+
+// memoryGameEntry pairs a stored game with when it should be evicted.
+type memoryGameEntry struct {
+	rec       GameRecord
+	expiresAt time.Time
+}
+
+// memoryGameStore is the default, single-process GameStore. Like
+// redisGameStore it expires abandoned games on its own, via a ttl refreshed
+// on every Save and swept by a background goroutine rather than Redis's
+// native key expiry.
+type memoryGameStore struct {
+	mu    sync.RWMutex
+	games map[string]memoryGameEntry
+	ttl   time.Duration
+	done  chan struct{}
+}
+
+func newMemoryGameStore(ttl time.Duration) *memoryGameStore {
+	s := &memoryGameStore{games: make(map[string]memoryGameEntry), ttl: ttl, done: make(chan struct{})}
+	if ttl > 0 {
+		go s.sweepLoop()
+	}
+	return s
+}
+
+// sweepLoop periodically evicts expired games so abandoned ones are freed
+// even if nobody ever requests them again, until Close stops it.
+func (s *memoryGameStore) sweepLoop() {
+	ticker := time.NewTicker(s.ttl / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.sweep()
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *memoryGameStore) sweep() {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for gameID, entry := range s.games {
+		if now.After(entry.expiresAt) {
+			delete(s.games, gameID)
+		}
+	}
+}
+
+func (s *memoryGameStore) Load(_ context.Context, gameID string) (*GameRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entry, ok := s.games[gameID]
+	if !ok || (s.ttl > 0 && time.Now().After(entry.expiresAt)) {
+		return nil, ErrGameNotFound
+	}
+	return &entry.rec, nil
+}
+
+func (s *memoryGameStore) Save(_ context.Context, gameID string, rec *GameRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry := memoryGameEntry{rec: *rec}
+	if s.ttl > 0 {
+		entry.expiresAt = time.Now().Add(s.ttl)
+	}
+	s.games[gameID] = entry
+	return nil
+}
+
+func (s *memoryGameStore) Delete(_ context.Context, gameID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.games, gameID)
+	return nil
+}
+
+func (s *memoryGameStore) Close() error {
+	if s.ttl > 0 {
+		close(s.done)
+	}
+	return nil
+}
+
+// redisGameStore persists games in Redis so multiple replicas share state
+// and abandoned games expire on their own via a TTL.
+type redisGameStore struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func newRedisGameStore(addr, password string, db int, ttl time.Duration) *redisGameStore {
+	client := redis.NewClient(&redis.Options{Addr: addr, Password: password, DB: db})
+	return &redisGameStore{client: client, ttl: ttl}
+}
+
+func (s *redisGameStore) key(gameID string) string {
+	return "game:" + gameID
+}
+
+func (s *redisGameStore) Load(ctx context.Context, gameID string) (*GameRecord, error) {
+	data, err := s.client.Get(ctx, s.key(gameID)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrGameNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var rec GameRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (s *redisGameStore) Save(ctx context.Context, gameID string, rec *GameRecord) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, s.key(gameID), data, s.ttl).Err()
+}
+
+func (s *redisGameStore) Delete(ctx context.Context, gameID string) error {
+	return s.client.Del(ctx, s.key(gameID)).Err()
+}
+
+func (s *redisGameStore) Close() error {
+	return s.client.Close()
+}
+
+// newGameStore builds a GameStore from environment configuration.
+// GAME_STORE=redis selects the Redis backend (REDIS_ADDR, REDIS_PASSWORD,
+// REDIS_DB); anything else keeps games in memory, which is fine for a single
+// replica but doesn't survive a restart or scale out. GAME_TTL_SECONDS sets
+// how long an abandoned game is kept before it's evicted, for either backend.
+func newGameStore() GameStore {
+	ttl := 24 * time.Hour
+	if secs, err := strconv.Atoi(os.Getenv("GAME_TTL_SECONDS")); err == nil && secs > 0 {
+		ttl = time.Duration(secs) * time.Second
+	}
+
+	if os.Getenv("GAME_STORE") != "redis" {
+		return newMemoryGameStore(ttl)
+	}
+
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+	db, _ := strconv.Atoi(os.Getenv("REDIS_DB"))
+
+	log.Printf("Using Redis game store at %s (db %d, ttl %s)", addr, db, ttl)
+	return newRedisGameStore(addr, os.Getenv("REDIS_PASSWORD"), db, ttl)
+}
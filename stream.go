@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/notnil/chess"
+)
+
+// analyzeStreamHandler upgrades to Server-Sent Events and pushes every UCI
+// "info" line Stockfish produces while it iteratively deepens, followed by a
+// final "bestmove" event. Unlike analyzeHandler, which waits for the search
+// to finish, this lets a live analysis board show the running evaluation.
+func analyzeStreamHandler(c *gin.Context) {
+	var req AnalyzeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query params: " + err.Error()})
+		return
+	}
+
+	if _, err := chess.FEN(req.CurrentFEN); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid FEN string"})
+		return
+	}
+
+	if req.Depth == 0 && req.MoveTime == 0 {
+		req.MoveTime = 15000
+	}
+	req.Depth = engineLimits.clampDepth(req.Depth)
+	req.MoveTime = engineLimits.clampMoveTime(req.MoveTime)
+
+	multiPV := req.MultiPV
+	if multiPV <= 0 {
+		multiPV = defaultMultiPV
+	}
+	if multiPV > maxMultiPV {
+		multiPV = maxMultiPV
+	}
+
+	session, err := newRawSession(c.Request.Context(), enginePool.path)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start analysis engine: " + err.Error()})
+		return
+	}
+	defer session.Close()
+
+	setup := []string{
+		fmt.Sprintf("setoption name MultiPV value %d", multiPV),
+		"isready",
+		"ucinewgame",
+		fmt.Sprintf("position fen %s", req.CurrentFEN),
+	}
+	for _, cmd := range setup {
+		if err := session.send(cmd); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine setup failed: " + err.Error()})
+			return
+		}
+		if cmd == "isready" {
+			if _, err := session.waitFor("readyok"); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine setup failed: " + err.Error()})
+				return
+			}
+		}
+	}
+
+	goCmd := "go "
+	if req.Depth > 0 {
+		goCmd += fmt.Sprintf("depth %d", req.Depth)
+	} else {
+		goCmd += fmt.Sprintf("movetime %d", req.MoveTime)
+	}
+	if err := session.send(goCmd); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine search failed: " + err.Error()})
+		return
+	}
+
+	// Stop Stockfish as soon as the client disconnects, instead of letting
+	// the search run to completion for nobody.
+	clientGone := c.Request.Context().Done()
+	stopped := make(chan struct{})
+	go func() {
+		select {
+		case <-clientGone:
+			_ = session.send("stop")
+		case <-stopped:
+		}
+	}()
+	defer close(stopped)
+
+	events := make(chan gin.H, 8)
+	go func() {
+		defer close(events)
+		bestmoveLine, err := session.readSearch(func(line string) {
+			info, ok := parseInfoLine(line)
+			if !ok {
+				return
+			}
+			event := gin.H{
+				"depth":   info.Depth,
+				"multipv": info.MultiPV,
+				"pv":      info.PV,
+				"nodes":   info.Nodes,
+				"nps":     info.NPS,
+			}
+			if info.IsMate {
+				event["mate"] = info.Mate
+			} else {
+				event["score_cp"] = info.ScoreCP
+			}
+			events <- event
+		})
+		if err != nil {
+			return
+		}
+		events <- gin.H{"bestmove": parseBestMove(bestmoveLine)}
+	}()
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			c.SSEvent("info", event)
+			return true
+		case <-clientGone:
+			return false
+		}
+	})
+}
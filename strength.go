@@ -0,0 +1,74 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/notnil/chess/uci"
+)
+
+const (
+	minSupportedElo = 1320
+	maxSupportedElo = 3190
+)
+
+// StrengthProfile translates a requested playing strength into the concrete
+// UCI options Stockfish needs, clamping Elo into the range modern builds
+// actually support instead of trusting the caller (or, as before, ignoring
+// it entirely).
+type StrengthProfile struct {
+	Elo           int
+	LimitStrength bool
+	SkillLevel    *int
+	Contempt      *int
+	Threads       *int
+	Hash          *int
+}
+
+// NewStrengthProfile builds a StrengthProfile from a MoveRequest. Strength is
+// only limited when an Elo was actually requested; a zero Elo plays at full
+// strength, matching how Stockfish behaves with UCI_LimitStrength off.
+func NewStrengthProfile(req MoveRequest) StrengthProfile {
+	profile := StrengthProfile{
+		SkillLevel: req.SkillLevel,
+		Contempt:   req.Contempt,
+		Threads:    req.Threads,
+		Hash:       req.Hash,
+	}
+	if req.Elo <= 0 {
+		return profile
+	}
+
+	elo := req.Elo
+	if elo < minSupportedElo {
+		elo = minSupportedElo
+	}
+	if elo > maxSupportedElo {
+		elo = maxSupportedElo
+	}
+	profile.Elo = elo
+	profile.LimitStrength = true
+	return profile
+}
+
+// Commands returns the UCI setoption commands needed to apply this profile.
+func (p StrengthProfile) Commands() []uci.Cmd {
+	cmds := []uci.Cmd{
+		uci.CmdSetOption{Name: "UCI_LimitStrength", Value: strconv.FormatBool(p.LimitStrength)},
+	}
+	if p.LimitStrength {
+		cmds = append(cmds, uci.CmdSetOption{Name: "UCI_Elo", Value: strconv.Itoa(p.Elo)})
+	}
+	if p.SkillLevel != nil {
+		cmds = append(cmds, uci.CmdSetOption{Name: "Skill Level", Value: strconv.Itoa(*p.SkillLevel)})
+	}
+	if p.Contempt != nil {
+		cmds = append(cmds, uci.CmdSetOption{Name: "Contempt", Value: strconv.Itoa(*p.Contempt)})
+	}
+	if p.Threads != nil {
+		cmds = append(cmds, uci.CmdSetOption{Name: "Threads", Value: strconv.Itoa(*p.Threads)})
+	}
+	if p.Hash != nil {
+		cmds = append(cmds, uci.CmdSetOption{Name: "Hash", Value: strconv.Itoa(*p.Hash)})
+	}
+	return cmds
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// rawSessionSlots caps how many rawSession Stockfish processes (used by
+// /analyze, /analyze/stream, and the blunder search path) can be spawned at
+// once, separately from enginePool's own POOL_SIZE engines. Without this, a
+// busy client could spin up unbounded Stockfish subprocesses regardless of
+// POOL_SIZE.
+var rawSessionSlots = make(chan struct{}, envInt("MAX_RAW_SESSIONS", 4))
+
+// rawSession is a minimal, direct stdin/stdout UCI client used by endpoints
+// that need the intermediate "info" lines (per-PV scores, depth-by-depth
+// updates) that the pooled uci.Engine discards once a search finishes.
+type rawSession struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Scanner
+
+	// writeMu serializes writes to stdin (and the stdin close in Close), since
+	// analyzeStreamHandler's disconnect watcher can call send("stop") from a
+	// goroutine concurrently with the deferred Close() tearing the session
+	// down at request end.
+	writeMu sync.Mutex
+}
+
+// newRawSession waits for a raw-session slot to free, respecting ctx
+// cancellation and request deadlines instead of blocking forever, then
+// starts a fresh Stockfish process and performs the UCI handshake.
+func newRawSession(ctx context.Context, path string) (*rawSession, error) {
+	select {
+	case rawSessionSlots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	cmd := exec.Command(path)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		<-rawSessionSlots
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		<-rawSessionSlots
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		<-rawSessionSlots
+		return nil, err
+	}
+
+	s := &rawSession{cmd: cmd, stdin: stdin, stdout: bufio.NewScanner(stdout)}
+	s.stdout.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	if err := s.send("uci"); err != nil {
+		s.Close()
+		return nil, err
+	}
+	if _, err := s.waitFor("uciok"); err != nil {
+		s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// send writes a single UCI command followed by a newline.
+func (s *rawSession) send(cmd string) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_, err := fmt.Fprintf(s.stdin, "%s\n", cmd)
+	return err
+}
+
+// waitFor reads lines until one contains substr, returning that line.
+func (s *rawSession) waitFor(substr string) (string, error) {
+	for s.stdout.Scan() {
+		line := s.stdout.Text()
+		if strings.Contains(line, substr) {
+			return line, nil
+		}
+	}
+	if err := s.stdout.Err(); err != nil {
+		return "", err
+	}
+	return "", io.ErrUnexpectedEOF
+}
+
+// readSearch streams "info" lines to onInfo until "bestmove" is seen, then
+// returns that bestmove line.
+func (s *rawSession) readSearch(onInfo func(line string)) (string, error) {
+	for s.stdout.Scan() {
+		line := s.stdout.Text()
+		switch {
+		case strings.HasPrefix(line, "info "):
+			onInfo(line)
+		case strings.HasPrefix(line, "bestmove"):
+			return line, nil
+		}
+	}
+	if err := s.stdout.Err(); err != nil {
+		return "", err
+	}
+	return "", io.ErrUnexpectedEOF
+}
+
+// Close terminates the underlying Stockfish process and frees its slot.
+func (s *rawSession) Close() {
+	_ = s.send("quit")
+	s.writeMu.Lock()
+	_ = s.stdin.Close()
+	s.writeMu.Unlock()
+	_ = s.cmd.Wait()
+	<-rawSessionSlots
+}
+
+// infoLine is a parsed "info depth ... score ... pv ..." line.
+type infoLine struct {
+	Depth   int
+	MultiPV int
+	ScoreCP int
+	Mate    int
+	IsMate  bool
+	Nodes   int
+	NPS     int
+	PV      []string
+}
+
+// centipawns returns a comparable evaluation in centipawns, collapsing mate
+// scores onto the same scale so lines can be ranked together.
+func (l infoLine) centipawns() int {
+	if !l.IsMate {
+		return l.ScoreCP
+	}
+	if l.Mate > 0 {
+		return 100000 - l.Mate
+	}
+	return -100000 - l.Mate
+}
+
+// parseInfoLine parses a raw UCI "info ..." line into an infoLine. It
+// returns ok=false for info lines that carry no score/pv (e.g. "currmove"
+// progress lines), which callers should ignore.
+func parseInfoLine(line string) (infoLine, bool) {
+	fields := strings.Fields(line)
+	var out infoLine
+	out.MultiPV = 1
+	haveScore := false
+
+	for i := 0; i < len(fields); i++ {
+		switch fields[i] {
+		case "depth":
+			if i+1 < len(fields) {
+				out.Depth, _ = strconv.Atoi(fields[i+1])
+			}
+		case "multipv":
+			if i+1 < len(fields) {
+				out.MultiPV, _ = strconv.Atoi(fields[i+1])
+			}
+		case "nodes":
+			if i+1 < len(fields) {
+				out.Nodes, _ = strconv.Atoi(fields[i+1])
+			}
+		case "nps":
+			if i+1 < len(fields) {
+				out.NPS, _ = strconv.Atoi(fields[i+1])
+			}
+		case "score":
+			if i+2 < len(fields) {
+				switch fields[i+1] {
+				case "cp":
+					out.ScoreCP, _ = strconv.Atoi(fields[i+2])
+					haveScore = true
+				case "mate":
+					out.Mate, _ = strconv.Atoi(fields[i+2])
+					out.IsMate = true
+					haveScore = true
+				}
+			}
+		case "pv":
+			if i+1 < len(fields) {
+				out.PV = fields[i+1:]
+			}
+			i = len(fields)
+		}
+	}
+
+	if !haveScore || len(out.PV) == 0 {
+		return infoLine{}, false
+	}
+	return out, true
+}
+
+// parseBestMove extracts the move token from a "bestmove e2e4 ponder e7e5" line.
+func parseBestMove(line string) string {
+	fields := strings.Fields(line)
+	if len(fields) >= 2 {
+		return fields[1]
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestParseInfoLineScoreCP(t *testing.T) {
+	line := "info depth 12 seldepth 18 multipv 2 score cp 34 nodes 55555 nps 900000 pv e2e4 e7e5"
+	info, ok := parseInfoLine(line)
+	if !ok {
+		t.Fatalf("parseInfoLine(%q) ok = false, want true", line)
+	}
+	if info.Depth != 12 || info.MultiPV != 2 || info.ScoreCP != 34 || info.Nodes != 55555 || info.NPS != 900000 {
+		t.Fatalf("parseInfoLine(%q) = %+v, unexpected fields", line, info)
+	}
+	if info.IsMate {
+		t.Fatalf("parseInfoLine(%q) IsMate = true, want false", line)
+	}
+	if want := []string{"e2e4", "e7e5"}; len(info.PV) != len(want) || info.PV[0] != want[0] || info.PV[1] != want[1] {
+		t.Fatalf("parseInfoLine(%q) PV = %v, want %v", line, info.PV, want)
+	}
+}
+
+func TestParseInfoLineMateScore(t *testing.T) {
+	line := "info depth 20 score mate 3 pv g1f3"
+	info, ok := parseInfoLine(line)
+	if !ok {
+		t.Fatalf("parseInfoLine(%q) ok = false, want true", line)
+	}
+	if !info.IsMate || info.Mate != 3 {
+		t.Fatalf("parseInfoLine(%q) = %+v, want IsMate=true Mate=3", line, info)
+	}
+	if info.MultiPV != 1 {
+		t.Fatalf("parseInfoLine(%q) MultiPV = %d, want default of 1", line, info.MultiPV)
+	}
+}
+
+func TestParseInfoLineIgnoresLinesWithoutScoreOrPV(t *testing.T) {
+	cases := []string{
+		"info currmove e2e4 currmovenumber 1",
+		"info depth 10 seldepth 12",
+	}
+	for _, line := range cases {
+		if _, ok := parseInfoLine(line); ok {
+			t.Fatalf("parseInfoLine(%q) ok = true, want false", line)
+		}
+	}
+}